@@ -0,0 +1,90 @@
+// Package config loads the optional `.pr-title.yaml` policy file that lets a
+// repository configure the validator without touching its workflow YAML.
+// Values set via `INPUT_*` environment variables still take precedence over
+// whatever is declared here; see how the main package merges the two.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultPath is used when INPUT_CONFIG_FILE is not set.
+const DefaultPath = ".github/pr-title.yaml"
+
+// ScopeConfig describes the allowed scopes and how strictly they're enforced.
+type ScopeConfig struct {
+	Values          []string `yaml:"values"`
+	Required        bool     `yaml:"required"`
+	RegexValidation bool     `yaml:"regex_validation"`
+}
+
+// SubjectConfig describes quality rules applied to the commit message
+// portion of the title (the text after the `<type>(<scope>):` prefix).
+type SubjectConfig struct {
+	MaxLength               int      `yaml:"max_length"`
+	MinLength               int      `yaml:"min_length"`
+	DisallowTrailingPeriod  bool     `yaml:"disallow_trailing_period"`
+	RequireLowercaseStart   bool     `yaml:"require_lowercase_start"`
+	ImperativeMoodBlocklist []string `yaml:"imperative_mood_blocklist"`
+}
+
+// FooterConfig describes a recognized commit footer token, along with any
+// alternate spellings a repository wants to accept for it.
+type FooterConfig struct {
+	Name     string   `yaml:"name"`
+	Synonyms []string `yaml:"synonyms"`
+	UseHash  bool     `yaml:"use_hash"`
+}
+
+// IssueConfig mirrors the INPUT_ISSUE_REGEX / INPUT_ISSUE_REQUIRED_IN inputs
+// for teams that prefer to keep policy in the config file.
+type IssueConfig struct {
+	Regex      string `yaml:"regex"`
+	RequiredIn string `yaml:"required_in"`
+}
+
+// LabelsConfig maps parsed title components to the labels applied to a PR.
+type LabelsConfig struct {
+	TypeMap     map[string]string `yaml:"type_map"`
+	ScopePrefix string            `yaml:"scope_prefix"`
+	Breaking    string            `yaml:"breaking"`
+}
+
+// Schema is the root of `.pr-title.yaml`.
+type Schema struct {
+	Types        []string       `yaml:"types"`
+	Scopes       ScopeConfig    `yaml:"scopes"`
+	Subject      SubjectConfig  `yaml:"subject"`
+	Footers      []FooterConfig `yaml:"footers"`
+	Issue        IssueConfig    `yaml:"issue"`
+	Labels       LabelsConfig   `yaml:"labels"`
+	SkipBranches []string       `yaml:"skip_branches"`
+}
+
+// Load reads and parses the policy file at path. A missing file is not an
+// error: it simply yields a zero-value Schema, since the config file is
+// optional.
+func Load(path string) (*Schema, error) {
+	if path == "" {
+		path = DefaultPath
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Schema{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading config file %q: %w", path, err)
+	}
+
+	var schema Schema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parsing config file %q: %w", path, err)
+	}
+
+	return &schema, nil
+}
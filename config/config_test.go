@@ -0,0 +1,64 @@
+// config_test.go
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	schema, err := Load(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	if err != nil {
+		t.Fatalf("Load() should not error on a missing file, got: %v", err)
+	}
+	if len(schema.Types) != 0 {
+		t.Errorf("Load() on a missing file should return an empty schema, got types: %v", schema.Types)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pr-title.yaml")
+	content := `
+types:
+  - feat
+  - fix
+scopes:
+  values:
+    - api
+    - ui
+  required: true
+subject:
+  max_length: 72
+  disallow_trailing_period: true
+issue:
+  regex: "[A-Z]+-[0-9]+"
+  required_in: branch
+skip_branches:
+  - dependabot/.*
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write test config file: %v", err)
+	}
+
+	schema, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned an error: %v", err)
+	}
+
+	if len(schema.Types) != 2 || schema.Types[0] != "feat" {
+		t.Errorf("Load() types = %v, want [feat fix]", schema.Types)
+	}
+	if !schema.Scopes.Required {
+		t.Errorf("Load() scopes.required = false, want true")
+	}
+	if schema.Subject.MaxLength != 72 {
+		t.Errorf("Load() subject.max_length = %d, want 72", schema.Subject.MaxLength)
+	}
+	if schema.Issue.RequiredIn != "branch" {
+		t.Errorf("Load() issue.required_in = %q, want %q", schema.Issue.RequiredIn, "branch")
+	}
+	if len(schema.SkipBranches) != 1 {
+		t.Errorf("Load() skip_branches = %v, want 1 entry", schema.SkipBranches)
+	}
+}
@@ -0,0 +1,84 @@
+// issue.go
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+)
+
+const defaultIssueRegex = `[A-Z]+-[0-9]+`
+
+// issueLocation identifies where INPUT_ISSUE_REQUIRED_IN expects to find a
+// matching issue key.
+type issueLocation string
+
+const (
+	issueLocationTitle  issueLocation = "title"
+	issueLocationBody   issueLocation = "body"
+	issueLocationBranch issueLocation = "branch"
+	issueLocationAny    issueLocation = "any"
+)
+
+// validateIssueReference checks that at least one issue key matching
+// INPUT_ISSUE_REGEX is present in the location(s) required by
+// INPUT_ISSUE_REQUIRED_IN. It is a no-op when no regex is configured.
+func (v *Validator) validateIssueReference(title, body, branch string) error {
+	pattern := v.issueRegexPattern()
+	if pattern == "" {
+		return nil
+	}
+
+	issueRegex, err := regexp.Compile(pattern)
+	if err != nil {
+		v.logger.Error("invalid issue regex", slog.String("regex", pattern), slog.Any("error", err))
+		return fmt.Errorf("invalid issue regex %q: %w", pattern, err)
+	}
+
+	sources := map[issueLocation]string{
+		issueLocationTitle:  title,
+		issueLocationBody:   body,
+		issueLocationBranch: branch,
+	}
+
+	required := v.issueRequiredIn()
+
+	for location, text := range sources {
+		if required != issueLocationAny && required != location {
+			continue
+		}
+
+		if keys := issueRegex.FindAllString(text, -1); len(keys) > 0 {
+			v.logger.Info("found issue reference", slog.String("location", string(location)), slog.Any("keys", keys))
+			return nil
+		}
+	}
+
+	v.logger.Error("no issue reference found",
+		slog.String("regex", pattern),
+		slog.String("required in", string(required)))
+	return fmt.Errorf("no issue reference matching %q found in %s", pattern, required)
+}
+
+// issueRegexPattern resolves the issue regex, preferring INPUT_ISSUE_REGEX
+// over the config file's `issue.regex`.
+func (v *Validator) issueRegexPattern() string {
+	if v.config.IssueRegex != "" {
+		return v.config.IssueRegex
+	}
+	return v.schema().Issue.Regex
+}
+
+func (v *Validator) issueRequiredIn() issueLocation {
+	requiredIn := v.config.IssueRequiredIn
+	if requiredIn == "" {
+		requiredIn = v.schema().Issue.RequiredIn
+	}
+
+	switch issueLocation(requiredIn) {
+	case issueLocationBody, issueLocationBranch, issueLocationAny:
+		return issueLocation(requiredIn)
+	default:
+		return issueLocationTitle
+	}
+}
@@ -0,0 +1,76 @@
+// issue_test.go
+package main
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+)
+
+func TestValidateIssueReference(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	tests := []struct {
+		name       string
+		config     Config
+		title      string
+		body       string
+		branch     string
+		shouldPass bool
+	}{
+		{
+			name:       "no regex configured, always passes",
+			config:     Config{},
+			title:      "feat: add endpoint",
+			shouldPass: true,
+		},
+		{
+			name:       "key found in title",
+			config:     Config{IssueRegex: `[A-Z]+-[0-9]+`},
+			title:      "feat: add endpoint (ABC-123)",
+			shouldPass: true,
+		},
+		{
+			name:       "no key anywhere",
+			config:     Config{IssueRegex: `[A-Z]+-[0-9]+`},
+			title:      "feat: add endpoint",
+			body:       "no ticket here",
+			branch:     "feature/add-endpoint",
+			shouldPass: false,
+		},
+		{
+			name:       "required in body, only branch has it",
+			config:     Config{IssueRegex: `[A-Z]+-[0-9]+`, IssueRequiredIn: "body"},
+			title:      "feat: add endpoint",
+			branch:     "ABC-123-add-endpoint",
+			shouldPass: false,
+		},
+		{
+			name:       "required in branch, branch has it",
+			config:     Config{IssueRegex: `[A-Z]+-[0-9]+`, IssueRequiredIn: "branch"},
+			title:      "feat: add endpoint",
+			branch:     "ABC-123-add-endpoint",
+			shouldPass: true,
+		},
+		{
+			name:       "required any, body has it",
+			config:     Config{IssueRegex: `[A-Z]+-[0-9]+`, IssueRequiredIn: "any"},
+			title:      "feat: add endpoint",
+			body:       "refs ABC-123",
+			shouldPass: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &Validator{logger: logger, config: tt.config}
+			err := validator.validateIssueReference(tt.title, tt.body, tt.branch)
+			if tt.shouldPass && err != nil {
+				t.Errorf("validateIssueReference() should pass but got error: %v", err)
+			}
+			if !tt.shouldPass && err == nil {
+				t.Errorf("validateIssueReference() should fail but passed")
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// Package labeler applies GitHub labels derived from a PR's parsed
+// Conventional Commits type/scope/breaking marker, so triage stays in sync
+// with the title without a separate automation.
+package labeler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	defaultScopePrefix  = "area/"
+	defaultBreakingName = "breaking-change"
+)
+
+// apiBaseURL is a var, rather than a const, so tests can point it at an
+// httptest.Server instead of the real GitHub API.
+var apiBaseURL = "https://api.github.com"
+
+// Mapping configures how parsed title components translate to labels.
+// TypeMap maps a commit type (e.g. "feat") to a label name (e.g.
+// "enhancement"); ScopePrefix and Breaking fall back to sane defaults when
+// left empty.
+type Mapping struct {
+	TypeMap     map[string]string
+	ScopePrefix string
+	Breaking    string
+}
+
+// Config configures where labels are applied.
+type Config struct {
+	Token  string
+	Owner  string
+	Repo   string
+	Number int
+}
+
+// ComputeLabels derives the desired label set from a parsed title.
+func ComputeLabels(titleType, scope string, breaking bool, mapping Mapping) []string {
+	var labels []string
+
+	if label, ok := mapping.TypeMap[titleType]; ok {
+		labels = append(labels, label)
+	}
+
+	if scope != "" {
+		labels = append(labels, mapping.scopePrefix()+scope)
+	}
+
+	if breaking {
+		labels = append(labels, mapping.breakingLabel())
+	}
+
+	return labels
+}
+
+func (m Mapping) scopePrefix() string {
+	if m.ScopePrefix != "" {
+		return m.ScopePrefix
+	}
+	return defaultScopePrefix
+}
+
+func (m Mapping) breakingLabel() string {
+	if m.Breaking != "" {
+		return m.Breaking
+	}
+	return defaultBreakingName
+}
+
+// isManaged reports whether an existing label is one this tool could have
+// applied, so Apply only ever removes labels it owns and never touches
+// unrelated ones a human added by hand.
+func (m Mapping) isManaged(label string) bool {
+	if label == m.breakingLabel() {
+		return true
+	}
+	if strings.HasPrefix(label, m.scopePrefix()) {
+		return true
+	}
+	for _, v := range m.TypeMap {
+		if v == label {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply fetches the PR's current labels, adds whichever of the desired
+// labels are missing, and — when removeStale is set — removes managed
+// labels that are no longer in the desired set.
+func Apply(cfg Config, desired []string, mapping Mapping, removeStale bool) error {
+	existing, err := listLabels(cfg)
+	if err != nil {
+		return fmt.Errorf("listing existing labels: %w", err)
+	}
+
+	toAdd := missing(desired, existing)
+	if len(toAdd) > 0 {
+		if err := addLabels(cfg, toAdd); err != nil {
+			return fmt.Errorf("adding labels %v: %w", toAdd, err)
+		}
+	}
+
+	if !removeStale {
+		return nil
+	}
+
+	for _, label := range existing {
+		if !mapping.isManaged(label) || contains(desired, label) {
+			continue
+		}
+		if err := removeLabel(cfg, label); err != nil {
+			return fmt.Errorf("removing stale label %q: %w", label, err)
+		}
+	}
+
+	return nil
+}
+
+func missing(desired, existing []string) []string {
+	var out []string
+	for _, label := range desired {
+		if !contains(existing, label) {
+			out = append(out, label)
+		}
+	}
+	return out
+}
+
+func contains(list []string, item string) bool {
+	for _, v := range list {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
+
+func issueURL(cfg Config, suffix string) string {
+	return fmt.Sprintf("%s/repos/%s/%s/issues/%d/labels%s", apiBaseURL, cfg.Owner, cfg.Repo, cfg.Number, suffix)
+}
+
+func listLabels(cfg Config) ([]string, error) {
+	req, err := http.NewRequest(http.MethodGet, issueURL(cfg, ""), nil)
+	if err != nil {
+		return nil, err
+	}
+	setHeaders(req, cfg)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("list labels request failed with status %d", resp.StatusCode)
+	}
+
+	var body []struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(body))
+	for i, l := range body {
+		names[i] = l.Name
+	}
+	return names, nil
+}
+
+func addLabels(cfg Config, labels []string) error {
+	payload, err := json.Marshal(struct {
+		Labels []string `json:"labels"`
+	}{Labels: labels})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, issueURL(cfg, ""), strings.NewReader(string(payload)))
+	if err != nil {
+		return err
+	}
+	setHeaders(req, cfg)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("add labels request failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func removeLabel(cfg Config, label string) error {
+	req, err := http.NewRequest(http.MethodDelete, issueURL(cfg, "/"+url.PathEscape(label)), nil)
+	if err != nil {
+		return err
+	}
+	setHeaders(req, cfg)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("remove label request failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func setHeaders(req *http.Request, cfg Config) {
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+cfg.Token)
+}
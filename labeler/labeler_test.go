@@ -0,0 +1,158 @@
+// labeler_test.go
+package labeler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestComputeLabels(t *testing.T) {
+	mapping := Mapping{TypeMap: map[string]string{"feat": "enhancement", "fix": "bug"}}
+
+	tests := []struct {
+		name      string
+		titleType string
+		scope     string
+		breaking  bool
+		expected  []string
+	}{
+		{
+			name:      "type only",
+			titleType: "feat",
+			expected:  []string{"enhancement"},
+		},
+		{
+			name:      "type and scope",
+			titleType: "fix",
+			scope:     "api",
+			expected:  []string{"bug", "area/api"},
+		},
+		{
+			name:      "breaking",
+			titleType: "feat",
+			breaking:  true,
+			expected:  []string{"enhancement", "breaking-change"},
+		},
+		{
+			name:      "unknown type yields no type label",
+			titleType: "chore",
+			expected:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComputeLabels(tt.titleType, tt.scope, tt.breaking, mapping)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ComputeLabels() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMappingIsManaged(t *testing.T) {
+	mapping := Mapping{TypeMap: map[string]string{"feat": "enhancement"}}
+
+	tests := []struct {
+		label   string
+		managed bool
+	}{
+		{"enhancement", true},
+		{"breaking-change", true},
+		{"area/api", true},
+		{"good-first-issue", false},
+	}
+
+	for _, tt := range tests {
+		if got := mapping.isManaged(tt.label); got != tt.managed {
+			t.Errorf("isManaged(%q) = %v, want %v", tt.label, got, tt.managed)
+		}
+	}
+}
+
+func TestMissing(t *testing.T) {
+	got := missing([]string{"a", "b", "c"}, []string{"b"})
+	sort.Strings(got)
+	want := []string{"a", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("missing() = %v, want %v", got, want)
+	}
+}
+
+func withTestServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	original := apiBaseURL
+	apiBaseURL = server.URL
+	t.Cleanup(func() {
+		apiBaseURL = original
+		server.Close()
+	})
+}
+
+func TestListLabelsParsesNames(t *testing.T) {
+	withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("listLabels() method = %q, want %q", r.Method, http.MethodGet)
+		}
+		if want := "/repos/o/r/issues/7/labels"; r.URL.Path != want {
+			t.Errorf("listLabels() path = %q, want %q", r.URL.Path, want)
+		}
+		w.Write([]byte(`[{"name": "bug"}, {"name": "area/api"}]`))
+	})
+
+	got, err := listLabels(Config{Owner: "o", Repo: "r", Number: 7})
+	if err != nil {
+		t.Fatalf("listLabels() returned error: %v", err)
+	}
+	want := []string{"bug", "area/api"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("listLabels() = %v, want %v", got, want)
+	}
+}
+
+func TestAddLabelsPostsDesiredLabels(t *testing.T) {
+	var gotMethod, gotPath string
+
+	withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.EscapedPath()
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if err := addLabels(Config{Owner: "o", Repo: "r", Number: 7}, []string{"bug"}); err != nil {
+		t.Fatalf("addLabels() returned error: %v", err)
+	}
+	if gotMethod != http.MethodPost {
+		t.Errorf("addLabels() method = %q, want %q", gotMethod, http.MethodPost)
+	}
+	if want := "/repos/o/r/issues/7/labels"; gotPath != want {
+		t.Errorf("addLabels() path = %q, want %q", gotPath, want)
+	}
+}
+
+// TestRemoveLabelEscapesSlashes is a regression test for a bug where a
+// slash-containing label (scope labels are "area/"+scope by default) was
+// concatenated unescaped into the URL, turning one path segment into two.
+func TestRemoveLabelEscapesSlashes(t *testing.T) {
+	var gotMethod, gotPath string
+
+	withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.EscapedPath()
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if err := removeLabel(Config{Owner: "o", Repo: "r", Number: 7}, "area/api"); err != nil {
+		t.Fatalf("removeLabel() returned error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Errorf("removeLabel() method = %q, want %q", gotMethod, http.MethodDelete)
+	}
+	if want := "/repos/o/r/issues/7/labels/area%2Fapi"; gotPath != want {
+		t.Errorf("removeLabel() path = %q, want %q (label must stay a single path segment)", gotPath, want)
+	}
+}
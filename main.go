@@ -9,6 +9,9 @@ import (
 	"strings"
 
 	"github.com/caarlos0/env"
+	"github.com/kontrolplane/pull-request-title-validator/config"
+	"github.com/kontrolplane/pull-request-title-validator/labeler"
+	"github.com/kontrolplane/pull-request-title-validator/reporter"
 )
 
 const (
@@ -19,30 +22,50 @@ var defaultConventionTypes = []string{
 	"fix", "feat", "chore", "docs", "build", "ci", "refactor", "perf", "test",
 }
 
+// defaultLabelTypeMap is used when the config file doesn't declare
+// `labels.type_map`.
+var defaultLabelTypeMap = map[string]string{
+	"feat": "enhancement",
+	"fix":  "bug",
+}
+
 type Config struct {
-	GithubEventName string `env:"GITHUB_EVENT_NAME"`
-	GithubEventPath string `env:"GITHUB_EVENT_PATH"`
-	Types           string `env:"INPUT_TYPES"`
-	Scopes          string `env:"INPUT_SCOPES"`
+	GithubEventName   string `env:"GITHUB_EVENT_NAME"`
+	GithubEventPath   string `env:"GITHUB_EVENT_PATH"`
+	Types             string `env:"INPUT_TYPES"`
+	Scopes            string `env:"INPUT_SCOPES"`
+	Footers           string `env:"INPUT_FOOTERS"`
+	Strict            bool   `env:"INPUT_STRICT" envDefault:"false"`
+	IssueRegex        string `env:"INPUT_ISSUE_REGEX"`
+	IssueRequiredIn   string `env:"INPUT_ISSUE_REQUIRED_IN"`
+	ConfigFile        string `env:"INPUT_CONFIG_FILE"`
+	GithubToken       string `env:"INPUT_GITHUB_TOKEN"`
+	GithubRepository  string `env:"GITHUB_REPOSITORY"`
+	DryRun            bool   `env:"INPUT_DRY_RUN" envDefault:"false"`
+	ApplyLabels       bool   `env:"INPUT_APPLY_LABELS" envDefault:"false"`
+	RemoveStaleLabels bool   `env:"INPUT_REMOVE_STALE_LABELS" envDefault:"false"`
+}
+
+type PullRequestHead struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
 }
 
 type PullRequest struct {
-	Title string `json:"title"`
+	Number int             `json:"number"`
+	Title  string          `json:"title"`
+	Body   string          `json:"body"`
+	Head   PullRequestHead `json:"head"`
 }
 
 type Event struct {
 	PullRequest PullRequest `json:"pull_request"`
 }
 
-type TitleComponents struct {
-	Type    string
-	Scope   string
-	Message string
-}
-
 type Validator struct {
-	logger *slog.Logger
-	config Config
+	logger     *slog.Logger
+	config     Config
+	fileConfig *config.Schema
 }
 
 func main() {
@@ -54,9 +77,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	fileCfg, err := config.Load(cfg.ConfigFile)
+	if err != nil {
+		logger.Error("unable to load config file", slog.Any("error", err))
+		os.Exit(1)
+	}
+
 	validator := &Validator{
-		logger: logger,
-		config: cfg,
+		logger:     logger,
+		config:     cfg,
+		fileConfig: fileCfg,
 	}
 
 	if err := validator.run(); err != nil {
@@ -86,30 +116,173 @@ func (v *Validator) run() error {
 		return err
 	}
 
-	title, err := v.fetchTitle()
+	event, err := v.fetchEvent()
 	if err != nil {
 		return err
 	}
 
-	components, err := v.parseTitle(title)
+	if v.skipsBranch(event.PullRequest.Head.Ref) {
+		v.logger.Info("skipping validation for branch", slog.String("branch", event.PullRequest.Head.Ref))
+		return nil
+	}
+
+	components, err := v.parseTitle(event.PullRequest.Title)
 	if err != nil {
 		return err
 	}
+	components.Footers = parseFooters(event.PullRequest.Body, v.schema().Footers)
 
-	if err := v.validateTitle(components); err != nil {
-		return err
+	failures := v.collectFailures(components, event)
+	v.logFooters(components.Footers)
+
+	if err := v.reportResults(event, failures); err != nil {
+		v.logger.Error("failed to report results to GitHub", slog.Any("error", err))
+	}
+
+	if err := v.applyLabels(event, components); err != nil {
+		v.logger.Error("failed to apply labels", slog.Any("error", err))
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%d validation rule(s) failed", len(failures))
 	}
 
 	v.logger.Info("commit title validated successfully",
 		slog.String("type", components.Type),
 		slog.String("scope", components.Scope),
 		slog.String("message", components.Message),
+		slog.Bool("breaking", components.Breaking),
 	)
 	v.logger.Info("the commit message adheres to the configured standard")
 
 	return nil
 }
 
+// collectFailures runs every validation rule against components and the
+// source event, without short-circuiting on the first failure, so all of
+// them can be reported at once.
+func (v *Validator) collectFailures(components *TitleComponents, event *Event) []reporter.Failure {
+	var failures []reporter.Failure
+
+	parsedTypes := v.parseTypes()
+	if err := v.validateType(components.Type, parsedTypes); err != nil {
+		v.logger.Error("error while checking the type against the allowed types",
+			slog.String("event name", v.config.GithubEventName),
+			slog.String("event path", v.config.GithubEventPath),
+			slog.Any("convention types", parsedTypes),
+		)
+		failures = append(failures, reporter.Failure{Rule: "type", Message: err.Error()})
+	}
+
+	parsedScopes := v.parseScopes()
+	if err := v.validateScope(components.Scope, parsedScopes, v.useScopeRegex()); err != nil && (len(parsedScopes) >= 1 || v.scopeRequired()) {
+		v.logger.Error("error while checking the scope against the allowed scopes",
+			slog.Any("error", err))
+		failures = append(failures, reporter.Failure{Rule: "scope", Message: err.Error()})
+	}
+
+	if err := v.validateBreakingChange(components); err != nil {
+		v.logger.Error("breaking-change marker and footers disagree",
+			slog.Any("error", err))
+		failures = append(failures, reporter.Failure{Rule: "breaking-change", Message: err.Error()})
+	}
+
+	if err := v.validateSubject(components.Message); err != nil {
+		failures = append(failures, reporter.Failure{Rule: "subject", Message: err.Error()})
+	}
+
+	if err := v.validateIssueReference(event.PullRequest.Title, event.PullRequest.Body, event.PullRequest.Head.Ref); err != nil {
+		failures = append(failures, reporter.Failure{Rule: "issue-reference", Message: err.Error()})
+	}
+
+	return failures
+}
+
+// reportResults posts a Check Run reflecting failures, when a GitHub token
+// (or dry-run mode) is configured. It is a no-op otherwise.
+func (v *Validator) reportResults(event *Event, failures []reporter.Failure) error {
+	if v.config.GithubToken == "" && !v.config.DryRun {
+		return nil
+	}
+
+	owner, repo, _ := strings.Cut(v.config.GithubRepository, "/")
+
+	return reporter.Report(reporter.Config{
+		Token:  v.config.GithubToken,
+		Owner:  owner,
+		Repo:   repo,
+		SHA:    event.PullRequest.Head.SHA,
+		DryRun: v.config.DryRun,
+	}, failures)
+}
+
+// applyLabels derives the labels implied by components and applies them to
+// the PR, when INPUT_APPLY_LABELS and a GitHub token are both set.
+func (v *Validator) applyLabels(event *Event, components *TitleComponents) error {
+	if !v.config.ApplyLabels || v.config.GithubToken == "" {
+		return nil
+	}
+
+	mapping := v.labelMapping()
+	desired := labeler.ComputeLabels(components.Type, components.Scope, components.Breaking, mapping)
+
+	owner, repo, _ := strings.Cut(v.config.GithubRepository, "/")
+	cfg := labeler.Config{
+		Token:  v.config.GithubToken,
+		Owner:  owner,
+		Repo:   repo,
+		Number: event.PullRequest.Number,
+	}
+
+	if err := labeler.Apply(cfg, desired, mapping, v.config.RemoveStaleLabels); err != nil {
+		return err
+	}
+
+	v.logger.Info("applied labels", slog.Any("labels", desired))
+	return nil
+}
+
+func (v *Validator) labelMapping() labeler.Mapping {
+	labels := v.schema().Labels
+
+	typeMap := labels.TypeMap
+	if len(typeMap) == 0 {
+		typeMap = defaultLabelTypeMap
+	}
+
+	return labeler.Mapping{
+		TypeMap:     typeMap,
+		ScopePrefix: labels.ScopePrefix,
+		Breaking:    labels.Breaking,
+	}
+}
+
+// schema returns the loaded config file, or an empty one if none was loaded
+// (e.g. in tests that construct a Validator directly).
+func (v *Validator) schema() *config.Schema {
+	if v.fileConfig == nil {
+		return &config.Schema{}
+	}
+	return v.fileConfig
+}
+
+// skipsBranch reports whether branch matches one of the config file's
+// `skip_branches` patterns. Each pattern is matched as a regular expression
+// against the whole branch name.
+func (v *Validator) skipsBranch(branch string) bool {
+	for _, pattern := range v.schema().SkipBranches {
+		matched, err := regexp.MatchString("^(?:"+pattern+")$", branch)
+		if err != nil {
+			v.logger.Warn("invalid skip_branches pattern", slog.String("pattern", pattern), slog.Any("error", err))
+			continue
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (v *Validator) validateEventType() error {
 	if v.config.GithubEventName != "pull_request" && v.config.GithubEventName != "pull_request_target" {
 		v.logger.Error("invalid event type", slog.String("event", v.config.GithubEventName))
@@ -118,92 +291,52 @@ func (v *Validator) validateEventType() error {
 	return nil
 }
 
-func (v *Validator) fetchTitle() (string, error) {
+func (v *Validator) fetchEvent() (*Event, error) {
 	eventData, err := os.ReadFile(v.config.GithubEventPath)
 	if err != nil {
 		v.logger.Error("problem reading the event JSON file",
 			slog.String("path", v.config.GithubEventPath),
 			slog.Any("error", err))
-		return "", err
+		return nil, err
 	}
 
 	var event Event
 	if err := json.Unmarshal(eventData, &event); err != nil {
 		v.logger.Error("failed to unmarshal JSON", slog.Any("error", err))
-		return "", err
+		return nil, err
 	}
 
-	return event.PullRequest.Title, nil
+	return &event, nil
 }
 
-func (v *Validator) parseTitle(title string) (*TitleComponents, error) {
-	// Split title into prefix (type/scope) and message parts using colon as separator
-	prefix, message, found := strings.Cut(title, ":")
-	if !found {
-		v.logger.Error("title must include a message after the colon",
-			slog.String("desired format", desiredFormat),
-			slog.String("title", title))
-		return nil, fmt.Errorf("title missing colon separator")
-	}
-
-	// Clean up the message part
-	titleMessage := strings.TrimSpace(message)
-
-	// Extract type and scope from the prefix
-	titleType, titleScope := extractTypeAndScope(prefix)
-
-	// Validate that we found a type
-	if titleType == "" {
-		v.logger.Error("title must include a type",
-			slog.String("desired format", desiredFormat),
-			slog.String("title", title))
-		return nil, fmt.Errorf("title missing type")
-	}
-
-	return &TitleComponents{
-		Type:    titleType,
-		Scope:   titleScope,
-		Message: titleMessage,
-	}, nil
-}
-
-func extractTypeAndScope(prefix string) (titleType string, titleScope string) {
-	prefix = strings.TrimSpace(prefix)
-
-	// Check if prefix contains a scope in parentheses
-	if strings.Contains(prefix, "(") && strings.Contains(prefix, ")") {
-		// Extract scope using regex
-		scopeRegex := regexp.MustCompile(`\(([^)]+)\)`)
-
-		if matches := scopeRegex.FindStringSubmatch(prefix); len(matches) > 1 {
-			titleScope = matches[1]
-			titleType = strings.TrimSpace(strings.Split(prefix, "(")[0])
-			return titleType, titleScope
+// logFooters reports which of the configured footer tokens (INPUT_FOOTERS)
+// were found in the PR body, for visibility in the action log.
+func (v *Validator) logFooters(footers map[string][]string) {
+	for _, token := range parseCommaSeparatedList(v.config.Footers) {
+		if token == "" {
+			continue
+		}
+		if values, ok := footers[token]; ok {
+			v.logger.Info("found configured footer", slog.String("token", token), slog.Any("values", values))
 		}
 	}
-
-	// If no scope found or invalid format, use entire prefix as type
-	titleType = prefix
-	return titleType, titleScope
 }
 
-func (v *Validator) validateTitle(components *TitleComponents) error {
-	parsedTypes := v.parseTypes()
-	parsedScopes := v.parseScopes()
-
-	if err := v.validateType(components.Type, parsedTypes); err != nil {
-		v.logger.Error("error while checking the type against the allowed types",
-			slog.String("event name", v.config.GithubEventName),
-			slog.String("event path", v.config.GithubEventPath),
-			slog.Any("convention types", parsedTypes),
-		)
-		return err
+// validateBreakingChange enforces that the `!` marker on the title and a
+// `BREAKING CHANGE:` / `BREAKING-CHANGE:` footer in the PR body agree with
+// one another. It only runs in strict mode: outside of it, declaring the
+// breaking change either way (or both) is accepted.
+func (v *Validator) validateBreakingChange(components *TitleComponents) error {
+	if !v.config.Strict {
+		return nil
 	}
 
-	if err := v.validateScope(components.Scope, parsedScopes); err != nil && len(parsedScopes) >= 1 {
-		v.logger.Error("error while checking the scope against the allowed scopes",
-			slog.Any("error", err))
-		return err
+	footerBreaking := hasBreakingFooter(components.Footers)
+	if components.Breaking && !footerBreaking {
+		return fmt.Errorf("title marks a breaking change with '!' but no BREAKING CHANGE footer was found in the PR body")
+	}
+	if footerBreaking && !components.Breaking {
+		return fmt.Errorf("PR body declares a BREAKING CHANGE footer but the title is missing the '!' marker")
 	}
 
 	return nil
@@ -222,9 +355,26 @@ func (v *Validator) validateType(titleType string, allowedTypes []string) error
 	return fmt.Errorf("type '%s' is not allowed", titleType)
 }
 
-func (v *Validator) validateScope(titleScope string, allowedScopes []string) error {
+// validateScope checks titleScope against allowedScopes. When useRegex is
+// true each entry is matched as a (case-insensitive, suffix-anchored)
+// regular expression; otherwise entries must match exactly, ignoring case. An empty
+// allowedScopes list means "no fixed list" — any non-empty scope passes,
+// but a missing one still fails so that scopes.required can be enforced
+// without also declaring scopes.values.
+func (v *Validator) validateScope(titleScope string, allowedScopes []string, useRegex bool) error {
+	if len(allowedScopes) == 0 {
+		if titleScope == "" {
+			return fmt.Errorf("scope is required but missing")
+		}
+		return nil
+	}
+
 	for _, scope := range allowedScopes {
-		if regexp.MustCompile("(?i)" + scope + "$").MatchString(titleScope) {
+		if useRegex {
+			if regexp.MustCompile("(?i)" + scope + "$").MatchString(titleScope) {
+				return nil
+			}
+		} else if strings.EqualFold(scope, titleScope) {
 			return nil
 		}
 	}
@@ -232,22 +382,48 @@ func (v *Validator) validateScope(titleScope string, allowedScopes []string) err
 	return fmt.Errorf("scope '%s' is not allowed", titleScope)
 }
 
+// scopeRequired reports whether the config file demands a scope be present
+// even when no fixed `scopes.values` list is configured.
+func (v *Validator) scopeRequired() bool {
+	return v.schema().Scopes.Required
+}
+
+// useScopeRegex reports whether allowed scopes should be matched as regular
+// expressions. Scopes sourced from INPUT_SCOPES always use regex matching,
+// preserving the tool's long-standing behavior; scopes sourced purely from
+// the config file default to exact matching unless regex_validation is set.
+func (v *Validator) useScopeRegex() bool {
+	return v.config.Scopes != "" || v.schema().Scopes.RegexValidation
+}
+
+// parseTypes resolves the allowed types, preferring INPUT_TYPES over the
+// config file's `types` list over the built-in default, in that order.
 func (v *Validator) parseTypes() []string {
-	if v.config.Types == "" {
-		v.logger.Warn("no custom list of commit types passed, using fallback")
-		return defaultConventionTypes
+	if v.config.Types != "" {
+		return parseCommaSeparatedList(v.config.Types)
+	}
+
+	if len(v.schema().Types) > 0 {
+		return v.schema().Types
 	}
 
-	return parseCommaSeparatedList(v.config.Types)
+	v.logger.Warn("no custom list of commit types passed, using fallback")
+	return defaultConventionTypes
 }
 
+// parseScopes resolves the allowed scopes, preferring INPUT_SCOPES over the
+// config file's `scopes.values` over no restriction at all.
 func (v *Validator) parseScopes() []string {
-	if v.config.Scopes == "" {
-		v.logger.Warn("no custom list of commit scopes passed, using fallback")
-		return []string{}
+	if v.config.Scopes != "" {
+		return parseCommaSeparatedList(v.config.Scopes)
+	}
+
+	if len(v.schema().Scopes.Values) > 0 {
+		return v.schema().Scopes.Values
 	}
 
-	return parseCommaSeparatedList(v.config.Scopes)
+	v.logger.Warn("no custom list of commit scopes passed, using fallback")
+	return []string{}
 }
 
 func parseCommaSeparatedList(input string) []string {
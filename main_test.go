@@ -5,6 +5,8 @@ import (
 	"log/slog"
 	"os"
 	"testing"
+
+	"github.com/kontrolplane/pull-request-title-validator/config"
 )
 
 func TestExtractTypeAndScope(t *testing.T) {
@@ -54,7 +56,7 @@ func TestExtractTypeAndScope(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotType, gotScope := extractTypeAndScope(tt.prefix)
+			gotType, gotScope, _ := extractTypeAndScope(tt.prefix)
 			if gotType != tt.expectedType {
 				t.Errorf("extractTypeAndScope() type = %v, want %v", gotType, tt.expectedType)
 			}
@@ -166,11 +168,17 @@ func TestValidateScope(t *testing.T) {
 			allowedScopes: []string{},
 			shouldPass:    false, // Empty scope should not match empty pattern
 		},
+		{
+			name:          "non-empty scope with no restrictions passes",
+			titleScope:    "anything",
+			allowedScopes: []string{},
+			shouldPass:    true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validator.validateScope(tt.titleScope, tt.allowedScopes)
+			err := validator.validateScope(tt.titleScope, tt.allowedScopes, true)
 			if tt.shouldPass && err != nil {
 				t.Errorf("validateScope() should pass but got error: %v", err)
 			}
@@ -181,6 +189,75 @@ func TestValidateScope(t *testing.T) {
 	}
 }
 
+func TestValidateScopeExactMatch(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	validator := &Validator{logger: logger}
+
+	tests := []struct {
+		name          string
+		titleScope    string
+		allowedScopes []string
+		shouldPass    bool
+	}{
+		{
+			name:          "exact match passes",
+			titleScope:    "api",
+			allowedScopes: []string{"api", "ui"},
+			shouldPass:    true,
+		},
+		{
+			name:          "regex metacharacters are treated literally",
+			titleScope:    "package/utils",
+			allowedScopes: []string{"package/.+"},
+			shouldPass:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.validateScope(tt.titleScope, tt.allowedScopes, false)
+			if tt.shouldPass && err != nil {
+				t.Errorf("validateScope() should pass but got error: %v", err)
+			}
+			if !tt.shouldPass && err == nil {
+				t.Errorf("validateScope() should fail but passed")
+			}
+		})
+	}
+}
+
+func TestScopeRequiredAndRegexResolution(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	t.Run("scopeRequired reflects config file", func(t *testing.T) {
+		v := &Validator{logger: logger, fileConfig: &config.Schema{Scopes: config.ScopeConfig{Required: true}}}
+		if !v.scopeRequired() {
+			t.Errorf("scopeRequired() = false, want true")
+		}
+	})
+
+	t.Run("useScopeRegex defaults true when INPUT_SCOPES is set", func(t *testing.T) {
+		v := &Validator{logger: logger, config: Config{Scopes: "api,ui"}}
+		if !v.useScopeRegex() {
+			t.Errorf("useScopeRegex() = false, want true for env-sourced scopes")
+		}
+	})
+
+	t.Run("useScopeRegex defaults false for file-only scopes", func(t *testing.T) {
+		v := &Validator{logger: logger, fileConfig: &config.Schema{Scopes: config.ScopeConfig{Values: []string{"api"}}}}
+		if v.useScopeRegex() {
+			t.Errorf("useScopeRegex() = true, want false when regex_validation is unset")
+		}
+	})
+
+	t.Run("useScopeRegex honors regex_validation: true", func(t *testing.T) {
+		v := &Validator{logger: logger, fileConfig: &config.Schema{Scopes: config.ScopeConfig{Values: []string{"api"}, RegexValidation: true}}}
+		if !v.useScopeRegex() {
+			t.Errorf("useScopeRegex() = false, want true when regex_validation is set")
+		}
+	})
+}
+
 func TestParseCommaSeparatedList(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,135 @@
+// Package reporter posts PR title validation results back to GitHub as a
+// Check Run, with one annotation per failed rule so they surface inline in
+// the PR's "Checks" tab.
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	checkName       = "PR Title Validator"
+	annotationPath  = ".github/pull_request_title"
+	annotationLevel = "failure"
+)
+
+// apiBaseURL is a var, rather than a const, so tests can point it at an
+// httptest.Server instead of the real GitHub API.
+var apiBaseURL = "https://api.github.com"
+
+// Failure is a single rule that failed validation (type, scope, subject
+// length, issue reference, ...), as produced by the Validator.
+type Failure struct {
+	Rule    string
+	Message string
+}
+
+// Config configures where and how a report is delivered.
+type Config struct {
+	Token  string
+	Owner  string
+	Repo   string
+	SHA    string
+	DryRun bool
+}
+
+type checkRunAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+}
+
+type checkRunOutput struct {
+	Title       string               `json:"title"`
+	Summary     string               `json:"summary"`
+	Annotations []checkRunAnnotation `json:"annotations,omitempty"`
+}
+
+type checkRunRequest struct {
+	Name       string         `json:"name"`
+	HeadSHA    string         `json:"head_sha"`
+	Status     string         `json:"status"`
+	Conclusion string         `json:"conclusion"`
+	Output     checkRunOutput `json:"output"`
+}
+
+// Report renders the given failures as a GitHub Check Run and delivers it:
+// posted to the Checks API, or printed to stdout when cfg.DryRun is set. An
+// empty failures slice reports a passing check.
+func Report(cfg Config, failures []Failure) error {
+	payload, err := json.MarshalIndent(buildCheckRun(cfg.SHA, failures), "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling check run payload: %w", err)
+	}
+
+	if cfg.DryRun {
+		fmt.Println(string(payload))
+		return nil
+	}
+
+	return send(cfg, payload)
+}
+
+func buildCheckRun(sha string, failures []Failure) checkRunRequest {
+	conclusion := "success"
+	summary := "All PR title validation rules passed."
+	var annotations []checkRunAnnotation
+
+	if len(failures) > 0 {
+		conclusion = "failure"
+		summary = fmt.Sprintf("%d rule(s) failed:\n", len(failures))
+		for _, f := range failures {
+			summary += fmt.Sprintf("- **%s**: %s\n", f.Rule, f.Message)
+			annotations = append(annotations, checkRunAnnotation{
+				Path:            annotationPath,
+				StartLine:       1,
+				EndLine:         1,
+				AnnotationLevel: annotationLevel,
+				Title:           f.Rule,
+				Message:         f.Message,
+			})
+		}
+	}
+
+	return checkRunRequest{
+		Name:       checkName,
+		HeadSHA:    sha,
+		Status:     "completed",
+		Conclusion: conclusion,
+		Output: checkRunOutput{
+			Title:       checkName,
+			Summary:     summary,
+			Annotations: annotations,
+		},
+	}
+}
+
+func send(cfg Config, payload []byte) error {
+	endpoint := fmt.Sprintf("%s/repos/%s/%s/check-runs", apiBaseURL, cfg.Owner, cfg.Repo)
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("building check run request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+cfg.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending check run: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("check run request failed with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
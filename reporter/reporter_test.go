@@ -0,0 +1,115 @@
+// reporter_test.go
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBuildCheckRunSuccess(t *testing.T) {
+	run := buildCheckRun("abc123", nil)
+
+	if run.Conclusion != "success" {
+		t.Errorf("buildCheckRun() conclusion = %q, want %q", run.Conclusion, "success")
+	}
+	if len(run.Output.Annotations) != 0 {
+		t.Errorf("buildCheckRun() should have no annotations on success, got %d", len(run.Output.Annotations))
+	}
+}
+
+func TestBuildCheckRunFailure(t *testing.T) {
+	failures := []Failure{
+		{Rule: "type", Message: "type 'invalid' is not allowed"},
+		{Rule: "subject", Message: "message is too long"},
+	}
+
+	run := buildCheckRun("abc123", failures)
+
+	if run.Conclusion != "failure" {
+		t.Errorf("buildCheckRun() conclusion = %q, want %q", run.Conclusion, "failure")
+	}
+	if len(run.Output.Annotations) != len(failures) {
+		t.Errorf("buildCheckRun() annotations = %d, want %d", len(run.Output.Annotations), len(failures))
+	}
+	if !strings.Contains(run.Output.Summary, "type") {
+		t.Errorf("buildCheckRun() summary should mention failed rules, got: %s", run.Output.Summary)
+	}
+}
+
+func TestReportDryRunDoesNotSend(t *testing.T) {
+	err := Report(Config{DryRun: true}, []Failure{{Rule: "type", Message: "bad"}})
+	if err != nil {
+		t.Fatalf("Report() in dry-run mode should not error, got: %v", err)
+	}
+}
+
+func TestBuildCheckRunMarshalsCleanly(t *testing.T) {
+	run := buildCheckRun("abc123", []Failure{{Rule: "type", Message: "bad"}})
+
+	if _, err := json.Marshal(run); err != nil {
+		t.Fatalf("buildCheckRun() result should marshal to JSON, got error: %v", err)
+	}
+}
+
+func withTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	original := apiBaseURL
+	apiBaseURL = server.URL
+	t.Cleanup(func() {
+		apiBaseURL = original
+		server.Close()
+	})
+	return server
+}
+
+func TestSendPostsCheckRun(t *testing.T) {
+	var gotMethod, gotPath, gotAuth, gotAccept, gotContentType string
+
+	withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		gotAccept = r.Header.Get("Accept")
+		gotContentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	cfg := Config{Token: "tok", Owner: "kontrolplane", Repo: "pull-request-title-validator", SHA: "abc123"}
+	if err := Report(cfg, nil); err != nil {
+		t.Fatalf("Report() returned error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("send() method = %q, want %q", gotMethod, http.MethodPost)
+	}
+	wantPath := "/repos/kontrolplane/pull-request-title-validator/check-runs"
+	if gotPath != wantPath {
+		t.Errorf("send() path = %q, want %q", gotPath, wantPath)
+	}
+	if gotAuth != "Bearer tok" {
+		t.Errorf("send() Authorization header = %q, want %q", gotAuth, "Bearer tok")
+	}
+	if gotAccept != "application/vnd.github+json" {
+		t.Errorf("send() Accept header = %q, want %q", gotAccept, "application/vnd.github+json")
+	}
+	if gotContentType != "application/json" {
+		t.Errorf("send() Content-Type header = %q, want %q", gotContentType, "application/json")
+	}
+}
+
+func TestSendReturnsErrorOnFailureStatus(t *testing.T) {
+	withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		io.WriteString(w, `{"message": "nope"}`)
+	})
+
+	cfg := Config{Token: "tok", Owner: "kontrolplane", Repo: "pull-request-title-validator", SHA: "abc123"}
+	if err := Report(cfg, nil); err == nil {
+		t.Errorf("Report() should error on a non-2xx response, got nil")
+	}
+}
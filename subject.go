@@ -0,0 +1,88 @@
+// subject.go
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+const defaultSubjectMaxLength = 72
+
+// defaultImperativeMoodBlocklist flags the most common past-tense/gerund
+// subject openers, which read as a changelog entry rather than an
+// instruction ("fixed the leak" vs "fix the leak").
+var defaultImperativeMoodBlocklist = []string{
+	"added", "adding", "fixed", "fixes", "fixing",
+	"removed", "removing", "updated", "updating", "changed", "changing",
+}
+
+// validateSubject runs every configured subject-quality rule against the
+// commit message and, rather than stopping at the first failure, returns a
+// single error listing every rule that failed so authors can fix everything
+// in one push.
+func (v *Validator) validateSubject(message string) error {
+	var failures []string
+
+	subject := v.schema().Subject
+
+	maxLength := subject.MaxLength
+	if maxLength == 0 {
+		maxLength = defaultSubjectMaxLength
+	}
+	if len(message) > maxLength {
+		failures = append(failures, fmt.Sprintf("message is %d characters long, exceeds the max of %d", len(message), maxLength))
+	}
+
+	if subject.MinLength > 0 && len(message) < subject.MinLength {
+		failures = append(failures, fmt.Sprintf("message is %d characters long, shorter than the min of %d", len(message), subject.MinLength))
+	}
+
+	if subject.DisallowTrailingPeriod && strings.HasSuffix(message, ".") {
+		failures = append(failures, "message must not end with a period")
+	}
+
+	if subject.RequireLowercaseStart && startsWithUpper(message) {
+		failures = append(failures, "message must start with a lowercase letter")
+	}
+
+	blocklist := subject.ImperativeMoodBlocklist
+	if len(blocklist) == 0 {
+		blocklist = defaultImperativeMoodBlocklist
+	}
+	if word := firstWord(message); containsFold(blocklist, word) {
+		failures = append(failures, fmt.Sprintf("message should use the imperative mood, not %q", word))
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	v.logger.Error("subject failed quality checks", slog.Any("failures", failures))
+	return fmt.Errorf("subject failed %d check(s): %s", len(failures), strings.Join(failures, "; "))
+}
+
+func startsWithUpper(s string) bool {
+	for _, r := range s {
+		return unicode.IsUpper(r)
+	}
+	return false
+}
+
+func firstWord(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+func containsFold(list []string, word string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+// subject_test.go
+package main
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/kontrolplane/pull-request-title-validator/config"
+)
+
+func TestValidateSubject(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	tests := []struct {
+		name           string
+		schema         config.Schema
+		message        string
+		shouldPass     bool
+		wantFailureCnt int
+	}{
+		{
+			name:       "passes with no rules configured",
+			message:    "add new endpoint",
+			shouldPass: true,
+		},
+		{
+			name:           "too long",
+			schema:         config.Schema{Subject: config.SubjectConfig{MaxLength: 10}},
+			message:        "add a new endpoint for listing widgets",
+			shouldPass:     false,
+			wantFailureCnt: 1,
+		},
+		{
+			name:           "too short",
+			schema:         config.Schema{Subject: config.SubjectConfig{MinLength: 20}},
+			message:        "fix bug",
+			shouldPass:     false,
+			wantFailureCnt: 1,
+		},
+		{
+			name:           "trailing period disallowed",
+			schema:         config.Schema{Subject: config.SubjectConfig{DisallowTrailingPeriod: true}},
+			message:        "add new endpoint.",
+			shouldPass:     false,
+			wantFailureCnt: 1,
+		},
+		{
+			name:           "requires lowercase start",
+			schema:         config.Schema{Subject: config.SubjectConfig{RequireLowercaseStart: true}},
+			message:        "Add new endpoint",
+			shouldPass:     false,
+			wantFailureCnt: 1,
+		},
+		{
+			name:           "imperative mood violation",
+			message:        "added new endpoint",
+			shouldPass:     false,
+			wantFailureCnt: 1,
+		},
+		{
+			name: "multiple violations aggregate into one error",
+			schema: config.Schema{Subject: config.SubjectConfig{
+				MaxLength:              10,
+				DisallowTrailingPeriod: true,
+				RequireLowercaseStart:  true,
+			}},
+			message:        "Added a brand new endpoint for listing widgets.",
+			shouldPass:     false,
+			wantFailureCnt: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &Validator{logger: logger, fileConfig: &tt.schema}
+			err := validator.validateSubject(tt.message)
+
+			if tt.shouldPass {
+				if err != nil {
+					t.Errorf("validateSubject() should pass but got error: %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("validateSubject() should fail but passed")
+			}
+			if gotCnt := strings.Count(err.Error(), ";") + 1; gotCnt != tt.wantFailureCnt {
+				t.Errorf("validateSubject() reported %d failure(s), want %d: %v", gotCnt, tt.wantFailureCnt, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// title.go
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/kontrolplane/pull-request-title-validator/config"
+)
+
+// TitleComponents holds everything extracted from a PR title and, when
+// available, its body — enough to validate it against the Conventional
+// Commits spec rather than just a `<type>(<scope>): <message>` prefix.
+type TitleComponents struct {
+	Type     string
+	Scope    string
+	Message  string
+	Breaking bool
+	Footers  map[string][]string
+}
+
+var footerLineRegex = regexp.MustCompile(`^([A-Za-z][A-Za-z -]*?)(: | #)(.+)$`)
+
+// breakingFooterKey is the canonical footer key that, per the Conventional
+// Commits spec, marks a breaking change on its own regardless of the `!`
+// marker on the title. Both spellings the spec allows ("BREAKING CHANGE"
+// and "BREAKING-CHANGE") canonicalize to this key.
+const breakingFooterKey = "BREAKING CHANGE"
+
+// footerMatcher recognizes one footer token under any of its configured
+// synonyms, and enforces the separator style (`: ` vs ` #`) that
+// FooterConfig.UseHash declares for it — mirroring git-sv's
+// CommitMessageFooterConfig.
+type footerMatcher struct {
+	canonical string
+	spellings []string
+	useHash   bool
+}
+
+func (m footerMatcher) matches(token string, usedHash bool) bool {
+	if usedHash != m.useHash {
+		return false
+	}
+	for _, spelling := range m.spellings {
+		if strings.EqualFold(spelling, token) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildFooterMatchers turns the configured footers into matchers, plus the
+// always-recognized BREAKING CHANGE / BREAKING-CHANGE spec footer.
+func buildFooterMatchers(configured []config.FooterConfig) []footerMatcher {
+	matchers := []footerMatcher{
+		{canonical: breakingFooterKey, spellings: []string{"BREAKING CHANGE", "BREAKING-CHANGE"}},
+	}
+
+	for _, footer := range configured {
+		matchers = append(matchers, footerMatcher{
+			canonical: footer.Name,
+			spellings: append([]string{footer.Name}, footer.Synonyms...),
+			useHash:   footer.UseHash,
+		})
+	}
+
+	return matchers
+}
+
+func (v *Validator) parseTitle(title string) (*TitleComponents, error) {
+	// Split title into prefix (type/scope/breaking marker) and message parts
+	// using colon as separator
+	prefix, message, found := strings.Cut(title, ":")
+	if !found {
+		v.logger.Error("title must include a message after the colon",
+			slog.String("desired format", desiredFormat),
+			slog.String("title", title))
+		return nil, fmt.Errorf("title missing colon separator")
+	}
+
+	// Clean up the message part
+	titleMessage := strings.TrimSpace(message)
+
+	// Extract type, scope and breaking marker from the prefix
+	titleType, titleScope, breaking := extractTypeAndScope(prefix)
+
+	// Validate that we found a type
+	if titleType == "" {
+		v.logger.Error("title must include a type",
+			slog.String("desired format", desiredFormat),
+			slog.String("title", title))
+		return nil, fmt.Errorf("title missing type")
+	}
+
+	return &TitleComponents{
+		Type:     titleType,
+		Scope:    titleScope,
+		Message:  titleMessage,
+		Breaking: breaking,
+		Footers:  map[string][]string{},
+	}, nil
+}
+
+// extractTypeAndScope parses a conventional commit prefix such as
+// "feat(api)!" into its type, optional scope, and whether the `!`
+// breaking-change marker is present.
+func extractTypeAndScope(prefix string) (titleType string, titleScope string, breaking bool) {
+	prefix = strings.TrimSpace(prefix)
+
+	if strings.HasSuffix(prefix, "!") {
+		breaking = true
+		prefix = strings.TrimSuffix(prefix, "!")
+	}
+
+	// Check if prefix contains a scope in parentheses
+	if strings.Contains(prefix, "(") && strings.Contains(prefix, ")") {
+		// Extract scope using regex
+		scopeRegex := regexp.MustCompile(`\(([^)]+)\)`)
+
+		if matches := scopeRegex.FindStringSubmatch(prefix); len(matches) > 1 {
+			titleScope = matches[1]
+			titleType = strings.TrimSpace(strings.Split(prefix, "(")[0])
+			return titleType, titleScope, breaking
+		}
+	}
+
+	// If no scope found or invalid format, use entire prefix as type
+	titleType = prefix
+	return titleType, titleScope, breaking
+}
+
+// parseFooters scans a PR body for `Token: value` / `Token #value` footer
+// lines (e.g. `BREAKING CHANGE: ...`, `Jira #123`) and groups their values
+// by canonical token. configured footers are matched under any of their
+// declared synonyms (so `Jira:` and `jira:` land under the same key) and
+// must use the separator style (`: ` or ` #`) their `use_hash` setting
+// declares; anything else is grouped under its literal token as-is.
+func parseFooters(body string, configured []config.FooterConfig) map[string][]string {
+	matchers := buildFooterMatchers(configured)
+	footers := map[string][]string{}
+
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		matches := footerLineRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		token, separator, value := matches[1], matches[2], strings.TrimSpace(matches[3])
+		usedHash := strings.Contains(separator, "#")
+
+		key := token
+		for _, matcher := range matchers {
+			if matcher.matches(token, usedHash) {
+				key = matcher.canonical
+				break
+			}
+		}
+
+		footers[key] = append(footers[key], value)
+	}
+
+	return footers
+}
+
+// hasBreakingFooter reports whether the breaking-change footer is present.
+func hasBreakingFooter(footers map[string][]string) bool {
+	return len(footers[breakingFooterKey]) > 0
+}
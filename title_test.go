@@ -0,0 +1,169 @@
+// title_test.go
+package main
+
+import (
+	"log/slog"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/kontrolplane/pull-request-title-validator/config"
+)
+
+func TestExtractTypeAndScopeBreaking(t *testing.T) {
+	tests := []struct {
+		name          string
+		prefix        string
+		expectedType  string
+		expectedScope string
+		expectedBreak bool
+	}{
+		{
+			name:          "breaking with scope",
+			prefix:        "feat(api)!",
+			expectedType:  "feat",
+			expectedScope: "api",
+			expectedBreak: true,
+		},
+		{
+			name:          "breaking without scope",
+			prefix:        "fix!",
+			expectedType:  "fix",
+			expectedScope: "",
+			expectedBreak: true,
+		},
+		{
+			name:          "not breaking",
+			prefix:        "feat(api)",
+			expectedType:  "feat",
+			expectedScope: "api",
+			expectedBreak: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotScope, gotBreaking := extractTypeAndScope(tt.prefix)
+			if gotType != tt.expectedType {
+				t.Errorf("extractTypeAndScope() type = %v, want %v", gotType, tt.expectedType)
+			}
+			if gotScope != tt.expectedScope {
+				t.Errorf("extractTypeAndScope() scope = %v, want %v", gotScope, tt.expectedScope)
+			}
+			if gotBreaking != tt.expectedBreak {
+				t.Errorf("extractTypeAndScope() breaking = %v, want %v", gotBreaking, tt.expectedBreak)
+			}
+		})
+	}
+}
+
+func TestParseFooters(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		configured []config.FooterConfig
+		expected   map[string][]string
+	}{
+		{
+			name: "breaking change footer",
+			body: "Some description.\n\nBREAKING CHANGE: removes the v1 endpoint",
+			expected: map[string][]string{
+				"BREAKING CHANGE": {"removes the v1 endpoint"},
+			},
+		},
+		{
+			name: "hyphenated breaking change footer canonicalizes to the same key",
+			body: "BREAKING-CHANGE: drops support for Go 1.20",
+			expected: map[string][]string{
+				"BREAKING CHANGE": {"drops support for Go 1.20"},
+			},
+		},
+		{
+			name: "unconfigured generic token footer with hash",
+			body: "Fixes a bug.\n\nRefs #123",
+			expected: map[string][]string{
+				"Refs": {"123"},
+			},
+		},
+		{
+			name:     "no footers",
+			body:     "Just a plain description with no footers.",
+			expected: map[string][]string{},
+		},
+		{
+			name:       "synonyms canonicalize to the configured name",
+			body:       "Jira: ABC-1\njira: ABC-2",
+			configured: []config.FooterConfig{{Name: "Jira", Synonyms: []string{"jira"}}},
+			expected: map[string][]string{
+				"Jira": {"ABC-1", "ABC-2"},
+			},
+		},
+		{
+			name:       "use_hash true only matches the hash form",
+			body:       "Refs #123\nREFS: 456",
+			configured: []config.FooterConfig{{Name: "Refs", UseHash: true}},
+			expected: map[string][]string{
+				"Refs": {"123"},
+				"REFS": {"456"}, // wrong separator for the configured matcher, so it falls back to its own literal token
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseFooters(tt.body, tt.configured)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseFooters() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateBreakingChange(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	tests := []struct {
+		name       string
+		strict     bool
+		components *TitleComponents
+		shouldPass bool
+	}{
+		{
+			name:       "strict, marker and footer agree",
+			strict:     true,
+			components: &TitleComponents{Breaking: true, Footers: map[string][]string{"BREAKING CHANGE": {"x"}}},
+			shouldPass: true,
+		},
+		{
+			name:       "strict, marker without footer",
+			strict:     true,
+			components: &TitleComponents{Breaking: true, Footers: map[string][]string{}},
+			shouldPass: false,
+		},
+		{
+			name:       "strict, footer without marker",
+			strict:     true,
+			components: &TitleComponents{Breaking: false, Footers: map[string][]string{"BREAKING CHANGE": {"x"}}},
+			shouldPass: false,
+		},
+		{
+			name:       "not strict, mismatch allowed",
+			strict:     false,
+			components: &TitleComponents{Breaking: true, Footers: map[string][]string{}},
+			shouldPass: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &Validator{logger: logger, config: Config{Strict: tt.strict}}
+			err := validator.validateBreakingChange(tt.components)
+			if tt.shouldPass && err != nil {
+				t.Errorf("validateBreakingChange() should pass but got error: %v", err)
+			}
+			if !tt.shouldPass && err == nil {
+				t.Errorf("validateBreakingChange() should fail but passed")
+			}
+		})
+	}
+}